@@ -5,20 +5,112 @@
 package convertor
 
 import (
+	"bufio"
 	"bytes"
+	"encoding"
 	"encoding/binary"
+	"encoding/csv"
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/vmihailenco/msgpack/v4"
 )
 
+// registeredStringFormatter converts a registered value to its string form.
+type registeredStringFormatter func(any) string
+
+// registeredBytesFormatter converts a registered value to its byte form.
+type registeredBytesFormatter func(any) ([]byte, error)
+
+// stringFormatters and bytesFormatters hold the formatters Register adds, by type.
+var (
+	stringFormatters sync.Map
+	bytesFormatters  sync.Map
+)
+
+// Register adds custom ToString/ToBytes conversions for type T, consulted before the json.Marshal fallback.
+func Register[T any](toString func(T) string, toBytes func(T) ([]byte, error)) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	if toString != nil {
+		stringFormatters.Store(t, registeredStringFormatter(func(value any) string {
+			return toString(value.(T))
+		}))
+	}
+	if toBytes != nil {
+		bytesFormatters.Store(t, registeredBytesFormatter(func(value any) ([]byte, error) {
+			return toBytes(value.(T))
+		}))
+	}
+}
+
+func init() {
+	Register[time.Time](
+		func(t time.Time) string { return t.Format(time.RFC3339) },
+		func(t time.Time) ([]byte, error) { return t.MarshalBinary() },
+	)
+	Register[time.Duration](
+		func(d time.Duration) string { return d.String() },
+		func(d time.Duration) ([]byte, error) { return []byte(d.String()), nil },
+	)
+}
+
+// lookupStringFormatter returns value's string form from the registry or its error/fmt.Stringer/encoding.TextMarshaler interfaces.
+func lookupStringFormatter(value any) (string, bool) {
+	if f, ok := stringFormatters.Load(reflect.TypeOf(value)); ok {
+		return f.(registeredStringFormatter)(value), true
+	}
+
+	switch v := value.(type) {
+	case error:
+		return v.Error(), true
+	case fmt.Stringer:
+		return v.String(), true
+	case encoding.TextMarshaler:
+		if b, err := v.MarshalText(); err == nil {
+			return string(b), true
+		}
+	}
+
+	return "", false
+}
+
+// lookupBytesFormatter returns value's byte form from the registry or its encoding.BinaryMarshaler/TextMarshaler/error/fmt.Stringer interfaces.
+func lookupBytesFormatter(value any) ([]byte, bool) {
+	if f, ok := bytesFormatters.Load(reflect.TypeOf(value)); ok {
+		if b, err := f.(registeredBytesFormatter)(value); err == nil {
+			return b, true
+		}
+	}
+
+	switch v := value.(type) {
+	case encoding.BinaryMarshaler:
+		if b, err := v.MarshalBinary(); err == nil {
+			return b, true
+		}
+	case encoding.TextMarshaler:
+		if b, err := v.MarshalText(); err == nil {
+			return b, true
+		}
+	case error:
+		return []byte(v.Error()), true
+	case fmt.Stringer:
+		return []byte(v.String()), true
+	}
+
+	return nil, false
+}
+
 // ToBool convert string to a boolean
 func ToBool(s string) (bool, error) {
 	return strconv.ParseBool(s)
@@ -62,6 +154,9 @@ func ToBytes(value any) ([]byte, error) {
 	case decimal.Decimal:
 		return value.(decimal.Decimal).MarshalJSON()
 	default:
+		if b, ok := lookupBytesFormatter(value); ok {
+			return b, nil
+		}
 		newValue, err := json.Marshal(value)
 		return newValue, err
 	}
@@ -133,6 +228,9 @@ func ToString(value any) string {
 	case decimal.Decimal:
 		return value.(decimal.Decimal).String()
 	default:
+		if s, ok := lookupStringFormatter(value); ok {
+			return s
+		}
 		newValue, _ := json.Marshal(value)
 		return string(newValue)
 
@@ -153,6 +251,13 @@ func ToJson(value any) (string, error) {
 	return string(result), nil
 }
 
+// JsonToStruct convert a json string to a value of the given struct type
+func JsonToStruct[T any](s string) (T, error) {
+	var result T
+	err := json.Unmarshal([]byte(s), &result)
+	return result, err
+}
+
 // ToFloat convert value to a float64, if input is not a float return 0.0 and error
 func ToFloat(value any) (float64, error) {
 	v := reflect.ValueOf(value)
@@ -227,6 +332,57 @@ func ToMap[T any, K comparable, V any](array []T, iteratee func(T) (K, V)) map[K
 	return result
 }
 
+// structFieldInfo is the pre-computed per-field data StructToMap needs to build its output map.
+type structFieldInfo struct {
+	index     int
+	jsonTag   string
+	omitempty bool
+	asString  bool
+}
+
+// structToMapFieldCache caches []structFieldInfo by reflect.Type.
+var structToMapFieldCache sync.Map
+
+// getStructFieldInfo returns the cached field info for t, computing and storing it on first use.
+func getStructFieldInfo(t reflect.Type) []structFieldInfo {
+	if cached, ok := structToMapFieldCache.Load(t); ok {
+		return cached.([]structFieldInfo)
+	}
+
+	pattern := `^[A-Z]`
+	regex := regexp.MustCompile(pattern)
+
+	fieldNum := t.NumField()
+	infos := make([]structFieldInfo, 0, fieldNum)
+	for i := 0; i < fieldNum; i++ {
+		field := t.Field(i)
+		if !regex.MatchString(field.Name) {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		info := structFieldInfo{index: i, jsonTag: parts[0]}
+		for _, opt := range parts[1:] {
+			switch opt {
+			case "omitempty":
+				info.omitempty = true
+			case "string":
+				info.asString = true
+			}
+		}
+
+		infos = append(infos, info)
+	}
+
+	structToMapFieldCache.Store(t, infos)
+	return infos
+}
+
 // StructToMap convert struct to map, only convert exported struct field
 // map key is specified same as struct field tag `json` value
 func StructToMap(value any) (map[string]any, error) {
@@ -235,26 +391,234 @@ func StructToMap(value any) (map[string]any, error) {
 
 	if t.Kind() == reflect.Ptr {
 		t = t.Elem()
+		v = v.Elem()
 	}
 	if t.Kind() != reflect.Struct {
 		return nil, fmt.Errorf("data type %T not support, shuld be struct or pointer to struct", value)
 	}
 
-	result := make(map[string]any)
+	infos := getStructFieldInfo(t)
+	result := make(map[string]any, len(infos))
 
-	fieldNum := t.NumField()
+	for _, info := range infos {
+		fieldValue := v.Field(info.index)
+
+		if info.omitempty && fieldValue.IsZero() {
+			continue
+		}
+
+		if info.asString {
+			result[info.jsonTag] = fmt.Sprint(fieldValue.Interface())
+			continue
+		}
+
+		result[info.jsonTag] = fieldValue.Interface()
+	}
+
+	return result, nil
+}
+
+// MapToStruct convert map to struct, out should be a pointer to struct.
+func MapToStruct(m map[string]any, out any) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("data type %T not support, should be pointer to struct", out)
+	}
+
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("data type %T not support, should be pointer to struct", out)
+	}
+
+	return mapToStructValue(m, v)
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	decimalType = reflect.TypeOf(decimal.Decimal{})
+)
+
+// mapToStructValue populates the exported, json-tagged fields of v (a struct value) from m.
+func mapToStructValue(m map[string]any, v reflect.Value) error {
+	t := v.Type()
 	pattern := `^[A-Z]`
 	regex := regexp.MustCompile(pattern)
-	for i := 0; i < fieldNum; i++ {
-		name := t.Field(i).Name
-		tag := t.Field(i).Tag.Get("json")
-		if regex.MatchString(name) && tag != "" {
-			// result[name] = v.Field(i).Interface()
-			result[tag] = v.Field(i).Interface()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldValue := v.Field(i)
+
+		if !regex.MatchString(field.Name) || !fieldValue.CanSet() {
+			continue
+		}
+
+		if field.Anonymous {
+			fv := fieldValue
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					fv.Set(reflect.New(fv.Type().Elem()))
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				if err := mapToStructValue(m, fv); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		raw, ok := m[name]
+		if !ok || raw == nil {
+			continue
+		}
+
+		if err := setFieldValue(fieldValue, reflect.ValueOf(raw)); err != nil {
+			return fmt.Errorf("convertor: field %s: %w", field.Name, err)
 		}
 	}
 
-	return result, nil
+	return nil
+}
+
+// setFieldValue assigns raw into field, coercing numeric, decimal.Decimal and
+// time.Time values and recursing into nested structs and slices as needed.
+func setFieldValue(field reflect.Value, raw reflect.Value) error {
+	ft := field.Type()
+
+	if ft.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			field.Set(reflect.New(ft.Elem()))
+		}
+		return setFieldValue(field.Elem(), raw)
+	}
+
+	rawValue := raw.Interface()
+
+	switch ft {
+	case timeType:
+		s, ok := rawValue.(string)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to time.Time", rawValue)
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	case decimalType:
+		switch rv := rawValue.(type) {
+		case string:
+			d, err := decimal.NewFromString(rv)
+			if err != nil {
+				return err
+			}
+			field.Set(reflect.ValueOf(d))
+		case float64:
+			field.Set(reflect.ValueOf(decimal.NewFromFloat(rv)))
+		case decimal.Decimal:
+			field.Set(reflect.ValueOf(rv))
+		default:
+			return fmt.Errorf("cannot convert %T to decimal.Decimal", rawValue)
+		}
+		return nil
+	}
+
+	switch ft.Kind() {
+	case reflect.Struct:
+		if raw.Type().AssignableTo(ft) {
+			field.Set(raw)
+			return nil
+		}
+		sub, ok := rawValue.(map[string]any)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to %s", rawValue, ft)
+		}
+		return mapToStructValue(sub, field)
+	case reflect.Slice:
+		if raw.Type().AssignableTo(ft) {
+			field.Set(raw)
+			return nil
+		}
+		rv := reflect.ValueOf(rawValue)
+		if rv.Kind() != reflect.Slice {
+			return fmt.Errorf("cannot convert %T to %s", rawValue, ft)
+		}
+		out := reflect.MakeSlice(ft, rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			if err := setFieldValue(out.Index(i), reflect.ValueOf(rv.Index(i).Interface())); err != nil {
+				return err
+			}
+		}
+		field.Set(out)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch {
+		case raw.CanInt():
+			field.SetInt(raw.Int())
+		case raw.CanUint():
+			field.SetInt(int64(raw.Uint()))
+		case raw.CanFloat():
+			field.SetInt(int64(raw.Float()))
+		case raw.Kind() == reflect.String:
+			n, err := strconv.ParseInt(raw.String(), 10, 64)
+			if err != nil {
+				return err
+			}
+			field.SetInt(n)
+		default:
+			return fmt.Errorf("cannot convert %T to %s", rawValue, ft)
+		}
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch {
+		case raw.CanUint():
+			field.SetUint(raw.Uint())
+		case raw.CanInt():
+			field.SetUint(uint64(raw.Int()))
+		case raw.CanFloat():
+			field.SetUint(uint64(raw.Float()))
+		case raw.Kind() == reflect.String:
+			n, err := strconv.ParseUint(raw.String(), 10, 64)
+			if err != nil {
+				return err
+			}
+			field.SetUint(n)
+		default:
+			return fmt.Errorf("cannot convert %T to %s", rawValue, ft)
+		}
+		return nil
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case raw.CanFloat():
+			field.SetFloat(raw.Float())
+		case raw.CanInt():
+			field.SetFloat(float64(raw.Int()))
+		case raw.CanUint():
+			field.SetFloat(float64(raw.Uint()))
+		default:
+			return fmt.Errorf("cannot convert %T to %s", rawValue, ft)
+		}
+		return nil
+	}
+
+	if raw.Type().AssignableTo(ft) {
+		field.Set(raw)
+		return nil
+	}
+	if raw.Type().ConvertibleTo(ft) {
+		field.Set(raw.Convert(ft))
+		return nil
+	}
+
+	return fmt.Errorf("cannot convert %T to %s", rawValue, ft)
 }
 
 // MapToSlice convert a map to a slice based on iteratee function
@@ -316,6 +680,39 @@ func DecodeByte(data []byte, target any) error {
 	return decoder.Decode(target)
 }
 
+// EncodeMsgpack encode data to msgpack bytes, honor the `msgpack` struct tag and fall back to the `json` tag
+func EncodeMsgpack(v any) ([]byte, error) {
+	buffer := bytes.NewBuffer(nil)
+	encoder := msgpack.NewEncoder(buffer)
+	encoder.UseJSONTag(true)
+	err := encoder.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// DecodeMsgpack decode msgpack bytes to target object, honor the `msgpack` struct tag and fall back to the `json` tag
+func DecodeMsgpack(data []byte, target any) error {
+	decoder := msgpack.NewDecoder(bytes.NewBuffer(data))
+	decoder.UseJSONTag(true)
+	return decoder.Decode(target)
+}
+
+// ToMsgpack convert value to a msgpack encoded string
+func ToMsgpack(value any) (string, error) {
+	result, err := EncodeMsgpack(value)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+// FromMsgpack convert a msgpack encoded string to target object
+func FromMsgpack(data string, target any) error {
+	return DecodeMsgpack([]byte(data), target)
+}
+
 // ToDecimal Converts any value to the decimal type and returns 0 if the conversion fails
 func ToDecimal(value any) decimal.Decimal {
 	v := reflect.ValueOf(value)
@@ -345,3 +742,375 @@ func ToDecimal(value any) decimal.Decimal {
 		return result
 	}
 }
+
+// CsvQuoting controls how SliceToCsv quotes written fields.
+type CsvQuoting int
+
+const (
+	// CsvQuoteMinimal quotes a field only when encoding/csv.Writer requires it, e.g. when it
+	// contains the delimiter, a quote character or a line break, or starts with a space.
+	// This is encoding/csv's own default.
+	CsvQuoteMinimal CsvQuoting = iota
+	// CsvQuoteAll always wraps every written field in double quotes.
+	CsvQuoteAll
+)
+
+// CsvOption sets up CsvToSlice/SliceToCsv behavior.
+type CsvOption struct {
+	// Delimiter is the field delimiter, default ','.
+	Delimiter rune
+	// TrimSpace trims leading/trailing whitespace from every field before parsing.
+	TrimSpace bool
+	// UseCRLF causes SliceToCsv to use \r\n as the line terminator, as required by some windows programs.
+	UseCRLF bool
+	// Quoting selects SliceToCsv's quoting mode, default CsvQuoteMinimal.
+	Quoting CsvQuoting
+	// LazyQuotes relaxes CsvToSlice's parsing of malformed quotes, mirroring encoding/csv.Reader.LazyQuotes.
+	LazyQuotes bool
+	// FieldParsers maps a column name (matched against the `csv` struct tag, falling back
+	// to `json`) to a function used to parse that column's string value in CsvToSlice.
+	FieldParsers map[string]func(string) (any, error)
+	// FieldFormatters maps a column name to a function used to format that field's value
+	// to a string in SliceToCsv.
+	FieldFormatters map[string]func(any) (string, error)
+}
+
+// mergeCsvOptions folds opts into a single CsvOption, later options overriding earlier ones.
+func mergeCsvOptions(opts []CsvOption) CsvOption {
+	merged := CsvOption{Delimiter: ','}
+
+	for _, opt := range opts {
+		if opt.Delimiter != 0 {
+			merged.Delimiter = opt.Delimiter
+		}
+		if opt.TrimSpace {
+			merged.TrimSpace = true
+		}
+		if opt.UseCRLF {
+			merged.UseCRLF = true
+		}
+		if opt.Quoting != CsvQuoteMinimal {
+			merged.Quoting = opt.Quoting
+		}
+		if opt.LazyQuotes {
+			merged.LazyQuotes = true
+		}
+		for name, parser := range opt.FieldParsers {
+			if merged.FieldParsers == nil {
+				merged.FieldParsers = make(map[string]func(string) (any, error))
+			}
+			merged.FieldParsers[name] = parser
+		}
+		for name, formatter := range opt.FieldFormatters {
+			if merged.FieldFormatters == nil {
+				merged.FieldFormatters = make(map[string]func(any) (string, error))
+			}
+			merged.FieldFormatters[name] = formatter
+		}
+	}
+
+	return merged
+}
+
+// csvFieldTag returns the csv column name for a struct field, preferring the `csv` tag and falling back to `json`.
+func csvFieldTag(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("csv")
+	if tag == "" {
+		tag = field.Tag.Get("json")
+	}
+	if tag == "" || tag == "-" {
+		return "", false
+	}
+	return strings.Split(tag, ",")[0], true
+}
+
+// csvColumn is a struct field selected for CSV mapping, keyed by its CSV column name.
+type csvColumn struct {
+	index int
+	name  string
+}
+
+// csvColumns returns the exported, csv/json-tagged fields of t, in declaration order.
+func csvColumns(t reflect.Type) []csvColumn {
+	pattern := `^[A-Z]`
+	regex := regexp.MustCompile(pattern)
+
+	columns := make([]csvColumn, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !regex.MatchString(field.Name) {
+			continue
+		}
+
+		name, ok := csvFieldTag(field)
+		if !ok {
+			continue
+		}
+
+		columns = append(columns, csvColumn{index: i, name: name})
+	}
+
+	return columns
+}
+
+// CsvToSlice reads CSV data from reader into a slice of T, matching the header row's
+// column names against each field's `csv` tag (falling back to `json`).
+func CsvToSlice[T any](reader io.Reader, opts ...CsvOption) ([]T, error) {
+	opt := mergeCsvOptions(opts)
+
+	csvReader := csv.NewReader(reader)
+	csvReader.Comma = opt.Delimiter
+	csvReader.LazyQuotes = opt.LazyQuotes
+
+	rows, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []T{}, nil
+	}
+
+	var zero T
+	t := reflect.TypeOf(zero)
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("CsvToSlice: data type %T not support, should be struct", zero)
+	}
+
+	columnIndex := make(map[string]int, len(rows[0]))
+	for i, name := range rows[0] {
+		if opt.TrimSpace {
+			name = strings.TrimSpace(name)
+		}
+		columnIndex[name] = i
+	}
+
+	columns := csvColumns(t)
+
+	result := make([]T, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		var item T
+		v := reflect.ValueOf(&item).Elem()
+
+		for _, col := range columns {
+			rowIdx, ok := columnIndex[col.name]
+			if !ok || rowIdx >= len(row) {
+				continue
+			}
+
+			raw := row[rowIdx]
+			if opt.TrimSpace {
+				raw = strings.TrimSpace(raw)
+			}
+
+			fieldName := t.Field(col.index).Name
+
+			if parser, ok := opt.FieldParsers[col.name]; ok {
+				parsed, err := parser(raw)
+				if err != nil {
+					return nil, fmt.Errorf("CsvToSlice: field %s: %w", fieldName, err)
+				}
+				if err := setFieldValue(v.Field(col.index), reflect.ValueOf(parsed)); err != nil {
+					return nil, fmt.Errorf("CsvToSlice: field %s: %w", fieldName, err)
+				}
+				continue
+			}
+
+			if err := setFieldFromString(v.Field(col.index), raw); err != nil {
+				return nil, fmt.Errorf("CsvToSlice: field %s: %w", fieldName, err)
+			}
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// setFieldFromString parses raw into field, covering the basic kinds plus time.Time and decimal.Decimal.
+func setFieldFromString(field reflect.Value, raw string) error {
+	ft := field.Type()
+
+	if ft.Kind() == reflect.Ptr {
+		if raw == "" {
+			return nil
+		}
+		if field.IsNil() {
+			field.Set(reflect.New(ft.Elem()))
+		}
+		return setFieldFromString(field.Elem(), raw)
+	}
+
+	if raw == "" {
+		return nil
+	}
+
+	switch ft {
+	case timeType:
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	case decimalType:
+		d, err := decimal.NewFromString(raw)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(d))
+		return nil
+	}
+
+	switch ft.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("cannot convert string to %s", ft)
+	}
+
+	return nil
+}
+
+// SliceToCsv writes rows to writer as CSV, using each field's `csv` tag (falling back
+// to `json`) as the column name, with a header row written first.
+func SliceToCsv[T any](writer io.Writer, rows []T, opts ...CsvOption) error {
+	opt := mergeCsvOptions(opts)
+
+	var zero T
+	t := reflect.TypeOf(zero)
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("SliceToCsv: data type %T not support, should be struct", zero)
+	}
+
+	columns := csvColumns(t)
+
+	records := make([][]string, 0, len(rows)+1)
+
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.name
+	}
+	records = append(records, header)
+
+	for _, row := range rows {
+		v := reflect.ValueOf(row)
+		record := make([]string, len(columns))
+
+		for i, col := range columns {
+			fieldValue := v.Field(col.index)
+
+			if formatter, ok := opt.FieldFormatters[col.name]; ok {
+				s, err := formatter(fieldValue.Interface())
+				if err != nil {
+					return fmt.Errorf("SliceToCsv: field %s: %w", col.name, err)
+				}
+				record[i] = s
+				continue
+			}
+
+			record[i] = formatFieldToString(fieldValue)
+		}
+
+		records = append(records, record)
+	}
+
+	if opt.Quoting == CsvQuoteAll {
+		return writeCsvQuoteAll(writer, records, opt.Delimiter, opt.UseCRLF)
+	}
+
+	csvWriter := csv.NewWriter(writer)
+	csvWriter.Comma = opt.Delimiter
+	csvWriter.UseCRLF = opt.UseCRLF
+
+	for _, record := range records {
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// writeCsvQuoteAll writes records to writer with every field wrapped in double quotes.
+func writeCsvQuoteAll(writer io.Writer, records [][]string, delimiter rune, useCRLF bool) error {
+	newline := "\n"
+	if useCRLF {
+		newline = "\r\n"
+	}
+
+	buf := bufio.NewWriter(writer)
+	for _, record := range records {
+		for i, field := range record {
+			if i > 0 {
+				if _, err := buf.WriteRune(delimiter); err != nil {
+					return err
+				}
+			}
+			if _, err := buf.WriteString(`"` + strings.ReplaceAll(field, `"`, `""`) + `"`); err != nil {
+				return err
+			}
+		}
+		if _, err := buf.WriteString(newline); err != nil {
+			return err
+		}
+	}
+
+	return buf.Flush()
+}
+
+// formatFieldToString renders a struct field's value to its CSV column string.
+func formatFieldToString(field reflect.Value) string {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return ""
+		}
+		return formatFieldToString(field.Elem())
+	}
+
+	switch v := field.Interface().(type) {
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case decimal.Decimal:
+		return v.String()
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		return field.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(field.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(field.Bool())
+	default:
+		return fmt.Sprint(field.Interface())
+	}
+}
@@ -0,0 +1,246 @@
+package convertor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/duke-git/lancet/v2/internal"
+)
+
+type msgpackCase struct {
+	Name string `msgpack:"name"`
+	Age  int    `json:"age"`
+}
+
+func TestEncodeDecodeMsgpack(t *testing.T) {
+	assert := internal.NewAssert(t, "TestEncodeDecodeMsgpack")
+
+	s := msgpackCase{Name: "test", Age: 18}
+	data, err := EncodeMsgpack(s)
+	assert.IsNil(err)
+
+	var out msgpackCase
+	err = DecodeMsgpack(data, &out)
+	assert.IsNil(err)
+	assert.Equal(s, out)
+}
+
+func TestToFromMsgpack(t *testing.T) {
+	assert := internal.NewAssert(t, "TestToFromMsgpack")
+
+	s := msgpackCase{Name: "test", Age: 18}
+	str, err := ToMsgpack(s)
+	assert.IsNil(err)
+
+	var out msgpackCase
+	err = FromMsgpack(str, &out)
+	assert.IsNil(err)
+	assert.Equal(s, out)
+}
+
+type structToMapCase struct {
+	Name   string `json:"name"`
+	Age    int    `json:"age"`
+	Hidden string `json:"-"`
+	Bio    string `json:"bio,omitempty"`
+}
+
+func TestStructToMap(t *testing.T) {
+	assert := internal.NewAssert(t, "TestStructToMap")
+
+	s := structToMapCase{Name: "test", Age: 18, Hidden: "secret"}
+	m, err := StructToMap(s)
+
+	assert.IsNil(err)
+	assert.Equal("test", m["name"])
+	assert.Equal(18, m["age"])
+	_, hasHidden := m["-"]
+	assert.Equal(false, hasHidden)
+	_, hasBio := m["bio"]
+	assert.Equal(false, hasBio)
+}
+
+func BenchmarkStructToMap(b *testing.B) {
+	s := structToMapCase{Name: "test", Age: 18}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = StructToMap(s)
+	}
+}
+
+type mapToStructAddress struct {
+	City string `json:"city"`
+	Zip  int    `json:"zip"`
+}
+
+type mapToStructPerson struct {
+	Name      string               `json:"name"`
+	Age       int                  `json:"age"`
+	Address   mapToStructAddress   `json:"address"`
+	Tags      []string             `json:"tags"`
+	Addresses []mapToStructAddress `json:"addresses"`
+}
+
+func TestMapToStructRoundTripWithStructToMap(t *testing.T) {
+	assert := internal.NewAssert(t, "TestMapToStructRoundTripWithStructToMap")
+
+	p := mapToStructPerson{
+		Name:      "test",
+		Age:       30,
+		Address:   mapToStructAddress{City: "NYC", Zip: 10001},
+		Tags:      []string{"a", "b"},
+		Addresses: []mapToStructAddress{{City: "LA", Zip: 90001}},
+	}
+
+	m, err := StructToMap(p)
+	assert.IsNil(err)
+
+	var out mapToStructPerson
+	err = MapToStruct(m, &out)
+	assert.IsNil(err)
+	assert.Equal(p, out)
+}
+
+func TestMapToStructFromJsonShapedMap(t *testing.T) {
+	assert := internal.NewAssert(t, "TestMapToStructFromJsonShapedMap")
+
+	m := map[string]any{
+		"name": "test",
+		"age":  float64(30),
+		"address": map[string]any{
+			"city": "NYC",
+			"zip":  float64(10001),
+		},
+	}
+
+	var out mapToStructPerson
+	err := MapToStruct(m, &out)
+	assert.IsNil(err)
+	assert.Equal("test", out.Name)
+	assert.Equal(30, out.Age)
+	assert.Equal("NYC", out.Address.City)
+	assert.Equal(10001, out.Address.Zip)
+}
+
+func TestJsonToStruct(t *testing.T) {
+	assert := internal.NewAssert(t, "TestJsonToStruct")
+
+	out, err := JsonToStruct[mapToStructAddress](`{"city":"NYC","zip":10001}`)
+	assert.IsNil(err)
+	assert.Equal("NYC", out.City)
+	assert.Equal(10001, out.Zip)
+}
+
+type csvCase struct {
+	Name string `csv:"name"`
+	Age  int    `csv:"age"`
+}
+
+func TestCsvToSliceAndSliceToCsv(t *testing.T) {
+	assert := internal.NewAssert(t, "TestCsvToSliceAndSliceToCsv")
+
+	rows := []csvCase{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}}
+
+	buffer := &bytes.Buffer{}
+	err := SliceToCsv(buffer, rows)
+	assert.IsNil(err)
+
+	result, err := CsvToSlice[csvCase](strings.NewReader(buffer.String()))
+	assert.IsNil(err)
+	assert.Equal(rows, result)
+}
+
+func TestSliceToCsvQuoteAll(t *testing.T) {
+	assert := internal.NewAssert(t, "TestSliceToCsvQuoteAll")
+
+	rows := []csvCase{{Name: "Alice", Age: 30}}
+
+	buffer := &bytes.Buffer{}
+	err := SliceToCsv(buffer, rows, CsvOption{Quoting: CsvQuoteAll})
+	assert.IsNil(err)
+
+	assert.Equal(true, strings.Contains(buffer.String(), `"name","age"`))
+	assert.Equal(true, strings.Contains(buffer.String(), `"Alice","30"`))
+}
+
+type csvCaseWithUnexportedTaggedField struct {
+	Name   string `csv:"name"`
+	hidden string `csv:"hidden"`
+}
+
+func TestSliceToCsvSkipsUnexportedField(t *testing.T) {
+	assert := internal.NewAssert(t, "TestSliceToCsvSkipsUnexportedField")
+
+	rows := []csvCaseWithUnexportedTaggedField{{Name: "Alice", hidden: "secret"}}
+
+	buffer := &bytes.Buffer{}
+	err := SliceToCsv(buffer, rows)
+	assert.IsNil(err)
+	assert.Equal(false, strings.Contains(buffer.String(), "secret"))
+
+	result, err := CsvToSlice[csvCaseWithUnexportedTaggedField](strings.NewReader(buffer.String()))
+	assert.IsNil(err)
+	assert.Equal("Alice", result[0].Name)
+}
+
+func TestToStringRegisteredFormatter(t *testing.T) {
+	assert := internal.NewAssert(t, "TestToStringRegisteredFormatter")
+
+	tm := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.Equal("2023-01-02T03:04:05Z", ToString(tm))
+
+	assert.Equal("boom", ToString(errors.New("boom")))
+}
+
+type customStringer struct{}
+
+func (customStringer) String() string { return "custom" }
+
+func TestRegisterCustomType(t *testing.T) {
+	assert := internal.NewAssert(t, "TestRegisterCustomType")
+
+	assert.Equal("custom", ToString(customStringer{}))
+
+	type point struct{ X, Y int }
+	Register[point](
+		func(p point) string { return fmt.Sprintf("(%d,%d)", p.X, p.Y) },
+		nil,
+	)
+	assert.Equal("(1,2)", ToString(point{X: 1, Y: 2}))
+}
+
+func TestToBytesRegisteredFormatter(t *testing.T) {
+	assert := internal.NewAssert(t, "TestToBytesRegisteredFormatter")
+
+	tm := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	want, err := tm.MarshalBinary()
+	assert.IsNil(err)
+	got, err := ToBytes(tm)
+	assert.IsNil(err)
+	assert.Equal(want, got)
+
+	d := 90 * time.Second
+	got, err = ToBytes(d)
+	assert.IsNil(err)
+	assert.Equal([]byte(d.String()), got)
+}
+
+type customBytesType struct{ n int }
+
+func TestRegisterCustomTypeToBytes(t *testing.T) {
+	assert := internal.NewAssert(t, "TestRegisterCustomTypeToBytes")
+
+	Register[customBytesType](
+		nil,
+		func(c customBytesType) ([]byte, error) { return []byte(fmt.Sprintf("n=%d", c.n)), nil },
+	)
+
+	got, err := ToBytes(customBytesType{n: 7})
+	assert.IsNil(err)
+	assert.Equal([]byte("n=7"), got)
+}
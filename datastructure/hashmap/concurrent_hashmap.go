@@ -0,0 +1,196 @@
+package datastructure
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// defaultShardCount is the number of shards a ConcurrentHashMap uses when none is specified.
+const defaultShardCount = 32
+
+// concurrentHashMapShard is one independently locked bucket of a ConcurrentHashMap.
+type concurrentHashMapShard struct {
+	mu   sync.RWMutex
+	data map[any]any
+}
+
+// ConcurrentHashMap is a concurrent-safe hash map, sharded across a fixed power-of-two
+// number of independently locked buckets.
+type ConcurrentHashMap struct {
+	shards []*concurrentHashMapShard
+	mask   uint32
+}
+
+// NewConcurrentHashMap creates a ConcurrentHashMap with the default shard count (32).
+func NewConcurrentHashMap() *ConcurrentHashMap {
+	return NewConcurrentHashMapWithShards(defaultShardCount)
+}
+
+// NewConcurrentHashMapWithShards creates a ConcurrentHashMap with shardCount shards,
+// rounded up to the next power of two.
+func NewConcurrentHashMapWithShards(shardCount int) *ConcurrentHashMap {
+	if shardCount <= 0 {
+		shardCount = defaultShardCount
+	}
+	shardCount = nextPowerOfTwo(shardCount)
+
+	shards := make([]*concurrentHashMapShard, shardCount)
+	for i := range shards {
+		shards[i] = &concurrentHashMapShard{data: make(map[any]any)}
+	}
+
+	return &ConcurrentHashMap{shards: shards, mask: uint32(shardCount - 1)}
+}
+
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// shardFor returns the shard responsible for key.
+func (m *ConcurrentHashMap) shardFor(key any) *concurrentHashMapShard {
+	return m.shards[hashKey(key)&m.mask]
+}
+
+// hashKey hashes key with fnv-1a, using a reflect-based string rendering for non-string keys.
+func hashKey(key any) uint32 {
+	s, ok := key.(string)
+	if !ok {
+		s = reflectKeyString(key)
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum32()
+}
+
+// reflectKeyString renders a non-string comparable key to a stable string for hashing.
+func reflectKeyString(key any) string {
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	default:
+		return fmt.Sprintf("%v", key)
+	}
+}
+
+// Put sets key to value.
+func (m *ConcurrentHashMap) Put(key, value any) {
+	shard := m.shardFor(key)
+	shard.mu.Lock()
+	shard.data[key] = value
+	shard.mu.Unlock()
+}
+
+// Get returns the value stored for key, or nil if key is not present.
+func (m *ConcurrentHashMap) Get(key any) any {
+	shard := m.shardFor(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	return shard.data[key]
+}
+
+// Delete removes key from the map.
+func (m *ConcurrentHashMap) Delete(key any) {
+	shard := m.shardFor(key)
+	shard.mu.Lock()
+	delete(shard.data, key)
+	shard.mu.Unlock()
+}
+
+// Contains reports whether key is present in the map.
+func (m *ConcurrentHashMap) Contains(key any) bool {
+	shard := m.shardFor(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	_, ok := shard.data[key]
+	return ok
+}
+
+// PutIfAbsent sets key to value if key is not already present, reporting whether it stored.
+func (m *ConcurrentHashMap) PutIfAbsent(key, value any) bool {
+	shard := m.shardFor(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	if _, ok := shard.data[key]; ok {
+		return false
+	}
+	shard.data[key] = value
+	return true
+}
+
+// ComputeIfAbsent returns key's existing value, or stores and returns compute()'s result.
+func (m *ConcurrentHashMap) ComputeIfAbsent(key any, compute func() any) any {
+	shard := m.shardFor(key)
+
+	shard.mu.RLock()
+	if v, ok := shard.data[key]; ok {
+		shard.mu.RUnlock()
+		return v
+	}
+	shard.mu.RUnlock()
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	if v, ok := shard.data[key]; ok {
+		return v
+	}
+	v := compute()
+	shard.data[key] = v
+	return v
+}
+
+// Range calls f for each key/value pair, in no particular order, stopping early if f
+// returns false. f may safely call back into the map, since each shard is snapshotted
+// before its lock is released.
+func (m *ConcurrentHashMap) Range(f func(key, value any) bool) {
+	for _, shard := range m.shards {
+		shard.mu.RLock()
+		snapshot := make(map[any]any, len(shard.data))
+		for k, v := range shard.data {
+			snapshot[k] = v
+		}
+		shard.mu.RUnlock()
+
+		for k, v := range snapshot {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the total number of key/value pairs stored across all shards.
+func (m *ConcurrentHashMap) Size() int {
+	size := 0
+	for _, shard := range m.shards {
+		shard.mu.RLock()
+		size += len(shard.data)
+		shard.mu.RUnlock()
+	}
+	return size
+}
+
+// Clear removes all key/value pairs from the map.
+func (m *ConcurrentHashMap) Clear() {
+	for _, shard := range m.shards {
+		shard.mu.Lock()
+		shard.data = make(map[any]any)
+		shard.mu.Unlock()
+	}
+}
@@ -0,0 +1,128 @@
+package datastructure
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/duke-git/lancet/v2/internal"
+)
+
+func TestConcurrentHashMap_PutAndGet(t *testing.T) {
+	assert := internal.NewAssert(t, "TestConcurrentHashMap_PutAndGet")
+
+	cm := NewConcurrentHashMap()
+
+	cm.Put("abc", 3)
+	assert.Equal(3, cm.Get("abc"))
+	assert.IsNil(cm.Get("abcd"))
+
+	cm.Put("abc", 4)
+	assert.Equal(4, cm.Get("abc"))
+}
+
+func TestConcurrentHashMap_Delete(t *testing.T) {
+	assert := internal.NewAssert(t, "TestConcurrentHashMap_Delete")
+
+	cm := NewConcurrentHashMap()
+
+	cm.Put("abc", 3)
+	assert.Equal(3, cm.Get("abc"))
+
+	cm.Delete("abc")
+	assert.IsNil(cm.Get("abc"))
+}
+
+func TestConcurrentHashMap_Contains(t *testing.T) {
+	assert := internal.NewAssert(t, "TestConcurrentHashMap_Contains")
+
+	cm := NewConcurrentHashMap()
+	assert.Equal(false, cm.Contains("abc"))
+
+	cm.Put("abc", 3)
+	assert.Equal(true, cm.Contains("abc"))
+}
+
+func TestConcurrentHashMap_PutIfAbsent(t *testing.T) {
+	assert := internal.NewAssert(t, "TestConcurrentHashMap_PutIfAbsent")
+
+	cm := NewConcurrentHashMap()
+
+	assert.Equal(true, cm.PutIfAbsent("abc", 1))
+	assert.Equal(false, cm.PutIfAbsent("abc", 2))
+	assert.Equal(1, cm.Get("abc"))
+}
+
+func TestConcurrentHashMap_ComputeIfAbsent(t *testing.T) {
+	assert := internal.NewAssert(t, "TestConcurrentHashMap_ComputeIfAbsent")
+
+	cm := NewConcurrentHashMap()
+	calls := 0
+	compute := func() any {
+		calls++
+		return 42
+	}
+
+	assert.Equal(42, cm.ComputeIfAbsent("abc", compute))
+	assert.Equal(42, cm.ComputeIfAbsent("abc", compute))
+	assert.Equal(1, calls)
+}
+
+func TestConcurrentHashMap_RangeSizeClear(t *testing.T) {
+	assert := internal.NewAssert(t, "TestConcurrentHashMap_RangeSizeClear")
+
+	cm := NewConcurrentHashMap()
+	for i := 0; i < 10; i++ {
+		cm.Put("key"+strconv.Itoa(i), i)
+	}
+	assert.Equal(10, cm.Size())
+
+	seen := 0
+	cm.Range(func(k, v any) bool {
+		seen++
+		return true
+	})
+	assert.Equal(10, seen)
+
+	cm.Clear()
+	assert.Equal(0, cm.Size())
+}
+
+func TestConcurrentHashMap_RangeAllowsMutationOfSameShard(t *testing.T) {
+	assert := internal.NewAssert(t, "TestConcurrentHashMap_RangeAllowsMutationOfSameShard")
+
+	// A single shard guarantees every key below lands in the shard being ranged over.
+	cm := NewConcurrentHashMapWithShards(1)
+	for i := 0; i < 5; i++ {
+		cm.Put("key"+strconv.Itoa(i), i)
+	}
+
+	seen := 0
+	cm.Range(func(k, v any) bool {
+		seen++
+		cm.Put("added-"+k.(string), v)
+		cm.Delete(k)
+		return true
+	})
+
+	assert.Equal(5, seen)
+	assert.Equal(5, cm.Size())
+}
+
+func TestConcurrentHashMap_ConcurrentAccess(t *testing.T) {
+	assert := internal.NewAssert(t, "TestConcurrentHashMap_ConcurrentAccess")
+
+	cm := NewConcurrentHashMap()
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cm.Put(strconv.Itoa(i), i)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(100, cm.Size())
+}
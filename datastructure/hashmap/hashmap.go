@@ -0,0 +1,32 @@
+package datastructure
+
+// HashMap is a simple, non-concurrent-safe hash map. For concurrent use, see ConcurrentHashMap.
+type HashMap struct {
+	data map[any]any
+}
+
+// NewHashMap creates an empty HashMap.
+func NewHashMap() *HashMap {
+	return &HashMap{data: make(map[any]any)}
+}
+
+// Put sets key to value.
+func (m *HashMap) Put(key, value any) {
+	m.data[key] = value
+}
+
+// Get returns the value stored for key, or nil if key is not present.
+func (m *HashMap) Get(key any) any {
+	return m.data[key]
+}
+
+// Delete removes key from the map.
+func (m *HashMap) Delete(key any) {
+	delete(m.data, key)
+}
+
+// Contains reports whether key is present in the map.
+func (m *HashMap) Contains(key any) bool {
+	_, ok := m.data[key]
+	return ok
+}